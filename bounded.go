@@ -0,0 +1,23 @@
+package ttlcache
+
+import "time"
+
+// NewBoundedCache creates a Cache with both a time-based expiration and
+// a maximum item count, evicting the least-recently-used entry whenever
+// Set would otherwise exceed the capacity.
+func NewBoundedCache(ttl time.Duration, maxItems int) *Cache {
+	cache := NewTTLCache(ttl)
+	cache.SetCapacity(maxItems)
+	return cache
+}
+
+// SetCapacity sets the maximum number of items the cache may hold. Once
+// the limit is reached, the least-recently-used entry (as tracked by
+// Get/Set access) is evicted to make room for new ones. A value of 0 or
+// less means unlimited, which is the default.
+func (cache *Cache) SetCapacity(n int) {
+	cache.mutex.Lock()
+	defer cache.mutex.Unlock()
+	cache.maxItems = n
+	cache.evictOverCapacity()
+}
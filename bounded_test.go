@@ -0,0 +1,73 @@
+package ttlcache
+
+import (
+	"sync"
+	"testing"
+	"time"
+)
+
+func TestBoundedCacheEvictsLeastRecentlyUsed(t *testing.T) {
+	cache := NewBoundedCache(time.Minute, 2)
+
+	cache.Set("a", 1)
+	cache.Set("b", 2)
+
+	// touch `a` so `b` becomes the least-recently-used entry
+	if _, found := cache.Get("a"); !found {
+		t.Fatalf("Expected `a` to be present")
+	}
+
+	cache.Set("c", 3) // should evict `b`, not `a`
+
+	if cache.Count() != 2 {
+		t.Errorf("Expected cache to contain 2 items, got %d", cache.Count())
+	}
+	if _, found := cache.Get("b"); found {
+		t.Errorf("Expected `b` to have been evicted as least-recently-used")
+	}
+	if _, found := cache.Get("a"); !found {
+		t.Errorf("Expected `a` to still be present")
+	}
+	if _, found := cache.Get("c"); !found {
+		t.Errorf("Expected `c` to still be present")
+	}
+}
+
+func TestBoundedCacheFiresCapacityEviction(t *testing.T) {
+	cache := NewBoundedCache(time.Minute, 1)
+
+	var mu sync.Mutex
+	var gotKey string
+	var gotReason EvictReason
+	var wg sync.WaitGroup
+	wg.Add(1)
+	cache.SetEvictionCallback(func(key string, value interface{}, reason EvictReason) {
+		defer wg.Done()
+		mu.Lock()
+		gotKey, gotReason = key, reason
+		mu.Unlock()
+	})
+
+	cache.Set("a", 1)
+	cache.Set("b", 2) // evicts `a` to stay within capacity 1
+
+	wg.Wait()
+	mu.Lock()
+	defer mu.Unlock()
+	if gotKey != "a" || gotReason != ReasonCapacity {
+		t.Errorf("Expected `a` to be evicted with ReasonCapacity, got key %q reason %v", gotKey, gotReason)
+	}
+}
+
+func TestSetCapacityShrinksExistingCache(t *testing.T) {
+	cache := NewCache()
+	cache.Set("a", 1)
+	cache.Set("b", 2)
+	cache.Set("c", 3)
+
+	cache.SetCapacity(1)
+
+	if cache.Count() != 1 {
+		t.Errorf("Expected cache to shrink to 1 item, got %d", cache.Count())
+	}
+}
@@ -1,16 +1,105 @@
 package ttlcache
 
 import (
+	"container/list"
 	"sync"
+	"sync/atomic"
 	"time"
 )
 
+// entry is the value held by each element of Cache.order; it lets an
+// eviction walk the list back to the key it needs to remove from items.
+type entry struct {
+	key  string
+	item *Item
+}
+
 // Cache is a synchronised map of items that auto-expire once stale
 type Cache struct {
-	mutex sync.RWMutex
-	ttl   time.Duration
-	items map[string]*Item
-	isTTL bool
+	mutex     sync.RWMutex
+	ttl       time.Duration
+	items     map[string]*list.Element
+	order     *list.List
+	isTTL     bool
+	maxItems  int
+	onEvicted OnEvicted
+
+	evictOnce sync.Once
+	evictCh   chan evictEvent
+
+	// expirationMode is the default ExpirationMode applied to items that
+	// don't specify their own via SetTTLWithMode. Its zero value is
+	// Sliding, preserving the cache's historical always-touch behaviour.
+	expirationMode ExpirationMode
+
+	// Loader, if set, is invoked by GetOrLoad on a cache miss to fetch
+	// the value for key. The returned duration overrides the TTL for
+	// that entry; a zero duration falls back to the cache's default.
+	Loader func(key string) (interface{}, time.Duration, error)
+
+	loadsMutex sync.Mutex
+	loads      map[string]*loadCall
+
+	hits        atomic.Uint64
+	misses      atomic.Uint64
+	insertions  atomic.Uint64
+	evictions   atomic.Uint64
+	expirations atomic.Uint64
+}
+
+// SetEvictionCallback registers a function that is called whenever an
+// item leaves the cache, whether by expiration, deletion, replacement,
+// clearing, or capacity eviction. Pass nil to disable a previously set callback.
+func (cache *Cache) SetEvictionCallback(onEvicted OnEvicted) {
+	cache.mutex.Lock()
+	defer cache.mutex.Unlock()
+	cache.onEvicted = onEvicted
+}
+
+// evictionQueueSize is the buffer size of the queue feeding the eviction
+// worker. Notifications beyond it wait in their enqueueing goroutines
+// until the worker drains the queue.
+const evictionQueueSize = 1024
+
+// evictEvent is a queued invocation of a Cache's eviction callback.
+type evictEvent struct {
+	key      string
+	data     interface{}
+	reason   EvictReason
+	callback OnEvicted
+}
+
+// notifyEvicted records eviction metrics and, if a callback is
+// registered, queues it for delivery on a single dedicated worker
+// goroutine. The worker (started lazily on first use) delivers callbacks
+// one at a time, so handlers are free to call back into the cache
+// without deadlocking. notifyEvicted is always called with cache.mutex
+// held, so each enqueue is dispatched onto its own short-lived goroutine
+// rather than sent to evictCh directly: a slow or reentrant callback
+// could otherwise leave the queue full, and blocking here would wedge
+// every other method waiting on the same mutex.
+func (cache *Cache) notifyEvicted(key string, data interface{}, reason EvictReason) {
+	cache.evictions.Add(1)
+	if reason == ReasonExpired {
+		cache.expirations.Add(1)
+	}
+	if cache.onEvicted == nil {
+		return
+	}
+	cache.evictOnce.Do(cache.startEvictionWorker)
+	evt := evictEvent{key, data, reason, cache.onEvicted}
+	go func() { cache.evictCh <- evt }()
+}
+
+// startEvictionWorker allocates the eviction queue and starts the single
+// goroutine that drains it, invoking each queued callback in turn.
+func (cache *Cache) startEvictionWorker() {
+	cache.evictCh = make(chan evictEvent, evictionQueueSize)
+	go func() {
+		for evt := range cache.evictCh {
+			evt.callback(evt.key, evt.data, evt.reason)
+		}
+	}()
 }
 
 // Set is a thread-safe way to add new items to the map
@@ -22,7 +111,7 @@ func (cache *Cache) Set(key string, data interface{}) {
 	if cache.isTTL {
 		item.touch(cache.ttl)
 	}
-	cache.items[key] = item
+	cache.insert(key, item)
 }
 
 // SetTTL is a thread-safe way to add new items to the map with time TTL
@@ -35,31 +124,63 @@ func (cache *Cache) SetTTL(key string, data interface{}, ttl time.Duration) {
 	}
 	item := &Item{data: data}
 	item.touch(ttl)
-	cache.items[key] = item
+	cache.insert(key, item)
+}
+
+// insert adds or overwrites key with item, moving it to the front of the
+// LRU order, then evicts down to capacity if a limit is set. Caller must
+// hold cache.mutex.
+func (cache *Cache) insert(key string, item *Item) {
+	cache.insertions.Add(1)
+	if elem, exists := cache.items[key]; exists {
+		old := elem.Value.(*entry).item
+		elem.Value = &entry{key: key, item: item}
+		cache.order.MoveToFront(elem)
+		cache.notifyEvicted(key, old.data, ReasonReplaced)
+		return
+	}
+	elem := cache.order.PushFront(&entry{key: key, item: item})
+	cache.items[key] = elem
+	cache.evictOverCapacity()
+}
+
+// evictOverCapacity removes the least-recently-used entries until the
+// cache is back within maxItems. Caller must hold cache.mutex.
+func (cache *Cache) evictOverCapacity() {
+	if cache.maxItems <= 0 {
+		return
+	}
+	for len(cache.items) > cache.maxItems {
+		oldest := cache.order.Back()
+		if oldest == nil {
+			break
+		}
+		e := oldest.Value.(*entry)
+		cache.order.Remove(oldest)
+		delete(cache.items, e.key)
+		cache.notifyEvicted(e.key, e.item.data, ReasonCapacity)
+	}
 }
 
 // Get is a thread-safe way to lookup items
-// Every lookup, also touches the item, hence extending it's life
+// Every lookup, also touches the item, hence extending it's life (unless
+// the item's ExpirationMode is Absolute), and marks it as the
+// most-recently-used entry for capacity eviction
 func (cache *Cache) Get(key string) (data interface{}, found bool) {
-	cache.mutex.RLock()
-	defer cache.mutex.RUnlock()
-	item, exists := cache.items[key]
-	if cache.isTTL {
-		if !exists || item.expired() {
-			data = nil
-			found = false
-		} else {
-			item.touch(cache.ttl)
-			data = item.data
-			found = true
-		}
-	} else {
-		if exists {
-			return item.data, true
-		}
+	cache.mutex.Lock()
+	defer cache.mutex.Unlock()
+	elem, exists := cache.items[key]
+	if !exists || elem.Value.(*entry).item.expired() {
+		cache.misses.Add(1)
 		return nil, false
 	}
-	return
+	e := elem.Value.(*entry)
+	if cache.isTTL && cache.effectiveMode(e.item) == Sliding {
+		e.item.touch(cache.ttl)
+	}
+	cache.order.MoveToFront(elem)
+	cache.hits.Add(1)
+	return e.item.data, true
 }
 
 // Count returns the number of items in the cache
@@ -75,18 +196,23 @@ func (cache *Cache) Count() int {
 func (cache *Cache) Clear() {
 	cache.mutex.Lock()
 	defer cache.mutex.Unlock()
-	for key := range cache.items {
+	for key, elem := range cache.items {
 		delete(cache.items, key)
+		cache.notifyEvicted(key, elem.Value.(*entry).item.data, ReasonCleared)
 	}
+	cache.order.Init()
 }
 
 func (cache *Cache) ttlCleanup() {
 	cache.mutex.Lock()
 	defer cache.mutex.Unlock()
 	if cache.isTTL {
-		for key, item := range cache.items {
-			if item.expired() {
+		for key, elem := range cache.items {
+			e := elem.Value.(*entry)
+			if e.item.expired() {
+				cache.order.Remove(elem)
 				delete(cache.items, key)
+				cache.notifyEvicted(key, e.item.data, ReasonExpired)
 			}
 		}
 	}
@@ -95,8 +221,12 @@ func (cache *Cache) ttlCleanup() {
 // Delete removes an entry from the cache at the specified key.
 // If no entry exists at the specified key, no action is taken
 func (cache *Cache) Delete(key string) {
-	if _, ok := cache.items[key]; ok {
+	cache.mutex.Lock()
+	defer cache.mutex.Unlock()
+	if elem, ok := cache.items[key]; ok {
+		cache.order.Remove(elem)
 		delete(cache.items, key)
+		cache.notifyEvicted(key, elem.Value.(*entry).item.data, ReasonDeleted)
 	}
 }
 
@@ -124,8 +254,10 @@ func (cache *Cache) startTTLCleanupTimer() {
 func NewTTLCache(duration time.Duration) *Cache {
 	cache := &Cache{
 		ttl:   duration,
-		items: map[string]*Item{},
+		items: map[string]*list.Element{},
+		order: list.New(),
 		isTTL: true,
+		loads: map[string]*loadCall{},
 	}
 	cache.startTTLCleanupTimer()
 	return cache
@@ -134,8 +266,10 @@ func NewTTLCache(duration time.Duration) *Cache {
 // NewCache is a helper to create instance of the Cache struct
 func NewCache() *Cache {
 	cache := &Cache{
-		items: map[string]*Item{},
+		items: map[string]*list.Element{},
+		order: list.New(),
 		isTTL: false,
+		loads: map[string]*loadCall{},
 	}
 	return cache
 }
@@ -1,6 +1,7 @@
 package ttlcache
 
 import (
+	"sync"
 	"testing"
 	"time"
 )
@@ -74,10 +75,10 @@ func TestExpiration(t *testing.T) {
 
 	<-time.After(500 * time.Millisecond)
 	cache.mutex.Lock()
-	cache.items["y"].touch(time.Second)
-	item, exists := cache.items["x"]
+	cache.items["y"].Value.(*entry).item.touch(time.Second)
+	elem, exists := cache.items["x"]
 	cache.mutex.Unlock()
-	if !exists || item.data != "1" || item.expired() {
+	if !exists || elem.Value.(*entry).item.data != "1" || elem.Value.(*entry).item.expired() {
 		t.Errorf("Expected `x` to not have expired after 200ms")
 	}
 
@@ -115,3 +116,117 @@ func TestExpiration(t *testing.T) {
 		t.Errorf("Expected cache to be empty")
 	}
 }
+
+func TestEvictionCallback(t *testing.T) {
+	// Each scenario uses its own cache so a key is only ever evicted once,
+	// keeping the assertions independent of goroutine dispatch ordering.
+	check := func(t *testing.T, wantReason EvictReason, trigger func(cache *Cache)) {
+		cache := NewCache()
+		var mu sync.Mutex
+		var gotKey string
+		var gotReason EvictReason
+		var wg sync.WaitGroup
+		wg.Add(1)
+		cache.SetEvictionCallback(func(key string, value interface{}, reason EvictReason) {
+			defer wg.Done()
+			mu.Lock()
+			gotKey, gotReason = key, reason
+			mu.Unlock()
+		})
+		trigger(cache)
+		wg.Wait()
+		mu.Lock()
+		defer mu.Unlock()
+		if gotKey != "k" || gotReason != wantReason {
+			t.Errorf("Expected eviction of `k` with reason %v, got key %q reason %v", wantReason, gotKey, gotReason)
+		}
+	}
+
+	t.Run("Replaced", func(t *testing.T) {
+		check(t, ReasonReplaced, func(cache *Cache) {
+			cache.Set("k", "v1")
+			cache.Set("k", "v2")
+		})
+	})
+	t.Run("Deleted", func(t *testing.T) {
+		check(t, ReasonDeleted, func(cache *Cache) {
+			cache.Set("k", "v1")
+			cache.Delete("k")
+		})
+	})
+	t.Run("Cleared", func(t *testing.T) {
+		check(t, ReasonCleared, func(cache *Cache) {
+			cache.Set("k", "v1")
+			cache.Clear()
+		})
+	})
+}
+
+func TestEvictionCallbackDeliveredSerially(t *testing.T) {
+	cache := NewCache()
+	const n = 500
+	var mu sync.Mutex
+	var inFlight int
+	var maxInFlight int
+	var delivered int
+	done := make(chan struct{})
+	cache.SetEvictionCallback(func(key string, value interface{}, reason EvictReason) {
+		mu.Lock()
+		inFlight++
+		if inFlight > maxInFlight {
+			maxInFlight = inFlight
+		}
+		mu.Unlock()
+
+		mu.Lock()
+		inFlight--
+		delivered++
+		if delivered == n {
+			close(done)
+		}
+		mu.Unlock()
+	})
+
+	for i := 0; i < n; i++ {
+		cache.Set("k", i)
+	}
+	cache.Clear()
+
+	select {
+	case <-done:
+	case <-time.After(5 * time.Second):
+		t.Fatal("Timed out waiting for all eviction callbacks to be delivered")
+	}
+
+	mu.Lock()
+	defer mu.Unlock()
+	if maxInFlight > 1 {
+		t.Errorf("Expected eviction callbacks to be delivered one at a time, saw %d in flight concurrently", maxInFlight)
+	}
+}
+
+func TestEvictionCallbackDoesNotBlockProducer(t *testing.T) {
+	cache := NewCache()
+	block := make(chan struct{})
+	cache.SetEvictionCallback(func(key string, value interface{}, reason EvictReason) {
+		<-block
+	})
+	defer close(block)
+
+	// Outrun the bounded eviction queue while the callback is stuck on its
+	// first invocation; Set must never block on cache.mutex while a slow
+	// or overflowing callback drains.
+	done := make(chan struct{})
+	go func() {
+		for i := 0; i < evictionQueueSize*2; i++ {
+			cache.Set("k", i)
+		}
+		close(done)
+	}()
+
+	select {
+	case <-done:
+	case <-time.After(3 * time.Second):
+		t.Fatal("Set blocked on a slow eviction callback instead of returning promptly")
+	}
+}
@@ -0,0 +1,23 @@
+package ttlcache
+
+// EvictReason describes why an item was removed from the cache
+type EvictReason int
+
+const (
+	// ReasonExpired indicates the item's TTL elapsed
+	ReasonExpired EvictReason = iota
+	// ReasonDeleted indicates the item was removed via Delete
+	ReasonDeleted
+	// ReasonReplaced indicates the item was overwritten by a subsequent Set/SetTTL call
+	ReasonReplaced
+	// ReasonCleared indicates the item was removed as part of a Clear
+	ReasonCleared
+	// ReasonCapacity indicates the item was evicted to make room under a capacity limit
+	ReasonCapacity
+)
+
+// OnEvicted is called whenever an item leaves the cache, along with the
+// reason it was removed. It is invoked on a dedicated goroutine, one
+// callback at a time, so handlers are free to call back into the cache
+// without deadlocking.
+type OnEvicted func(key string, value interface{}, reason EvictReason)
@@ -0,0 +1,47 @@
+package ttlcache
+
+import "time"
+
+// ExpirationMode controls whether a read extends an item's lifetime.
+type ExpirationMode int
+
+const (
+	// Sliding extends an item's expiration on every successful Get. It
+	// is the default, preserving the cache's historical behaviour.
+	Sliding ExpirationMode = iota
+	// Absolute leaves an item's expiration untouched on Get, so it
+	// expires at a fixed deadline regardless of how often it is read.
+	Absolute
+)
+
+// SetExpirationMode sets the default ExpirationMode applied to items
+// that don't specify their own via SetTTLWithMode.
+func (cache *Cache) SetExpirationMode(mode ExpirationMode) {
+	cache.mutex.Lock()
+	defer cache.mutex.Unlock()
+	cache.expirationMode = mode
+}
+
+// SetTTLWithMode is a thread-safe way to add a new item to the map with
+// its own TTL and ExpirationMode, overriding the cache's default mode
+// for this item alone.
+func (cache *Cache) SetTTLWithMode(key string, data interface{}, ttl time.Duration, mode ExpirationMode) {
+	cache.mutex.Lock()
+	defer cache.mutex.Unlock()
+	if !cache.isTTL {
+		cache.isTTL = true
+		cache.startTTLCleanupTimer()
+	}
+	item := &Item{data: data, mode: &mode}
+	item.touch(ttl)
+	cache.insert(key, item)
+}
+
+// effectiveMode returns the effective ExpirationMode for item, falling
+// back to the cache's default when the item has no override.
+func (cache *Cache) effectiveMode(item *Item) ExpirationMode {
+	if item.mode != nil {
+		return *item.mode
+	}
+	return cache.expirationMode
+}
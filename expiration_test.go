@@ -0,0 +1,50 @@
+package ttlcache
+
+import (
+	"testing"
+	"time"
+)
+
+func TestAbsoluteExpirationDoesNotExtendOnGet(t *testing.T) {
+	cache := NewTTLCache(300 * time.Millisecond)
+	cache.SetExpirationMode(Absolute)
+	cache.Set("hello", "world")
+
+	<-time.After(200 * time.Millisecond)
+	if _, found := cache.Get("hello"); !found {
+		t.Errorf("Expected `hello` to still be present before its deadline")
+	}
+
+	<-time.After(200 * time.Millisecond)
+	if _, found := cache.Get("hello"); found {
+		t.Errorf("Expected `hello` to have expired at its absolute deadline despite reads")
+	}
+}
+
+func TestSlidingExpirationExtendsOnGet(t *testing.T) {
+	cache := NewTTLCache(300 * time.Millisecond)
+	cache.Set("hello", "world")
+
+	<-time.After(200 * time.Millisecond)
+	if _, found := cache.Get("hello"); !found {
+		t.Errorf("Expected `hello` to still be present before its deadline")
+	}
+
+	<-time.After(200 * time.Millisecond)
+	if _, found := cache.Get("hello"); !found {
+		t.Errorf("Expected `hello` to have had its lifetime extended by the earlier Get")
+	}
+}
+
+func TestPerItemModeOverridesCacheDefault(t *testing.T) {
+	cache := NewTTLCache(300 * time.Millisecond) // cache default is Sliding
+	cache.SetTTLWithMode("hello", "world", 300*time.Millisecond, Absolute)
+
+	<-time.After(200 * time.Millisecond)
+	cache.Get("hello")
+
+	<-time.After(200 * time.Millisecond)
+	if _, found := cache.Get("hello"); found {
+		t.Errorf("Expected `hello` to honor its own Absolute override over the cache's Sliding default")
+	}
+}
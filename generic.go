@@ -0,0 +1,139 @@
+package ttlcache
+
+import (
+	"sync"
+	"time"
+)
+
+// genericItem is the generic counterpart of Item, avoiding the
+// interface{} boxing that the original Item incurs.
+type genericItem[V any] struct {
+	data    V
+	expires *time.Time
+}
+
+func (item *genericItem[V]) touch(duration time.Duration) {
+	expiration := time.Now().Add(duration)
+	item.expires = &expiration
+}
+
+func (item *genericItem[V]) expired() bool {
+	if item.expires == nil {
+		return false
+	}
+	return item.expires.Before(time.Now())
+}
+
+// TypedCache is a synchronised map of items that auto-expire once stale,
+// keyed and valued by type parameters instead of interface{}. It mirrors
+// Cache's TTL semantics without the boxing/unboxing cost of the
+// interface{}-based API, at the expense of the capacity and eviction
+// callback support Cache offers.
+type TypedCache[K comparable, V any] struct {
+	mutex sync.RWMutex
+	ttl   time.Duration
+	items map[K]*genericItem[V]
+	isTTL bool
+}
+
+// NewTypedCache is a helper to create an instance of TypedCache with a
+// fixed TTL applied to every item.
+func NewTypedCache[K comparable, V any](ttl time.Duration) *TypedCache[K, V] {
+	cache := &TypedCache[K, V]{
+		ttl:   ttl,
+		items: map[K]*genericItem[V]{},
+		isTTL: true,
+	}
+	cache.startTTLCleanupTimer()
+	return cache
+}
+
+// Set is a thread-safe way to add new items to the map
+func (cache *TypedCache[K, V]) Set(key K, value V) {
+	cache.mutex.Lock()
+	defer cache.mutex.Unlock()
+	item := &genericItem[V]{data: value}
+	if cache.isTTL {
+		item.touch(cache.ttl)
+	}
+	cache.items[key] = item
+}
+
+// SetTTL is a thread-safe way to add new items to the map with their own TTL
+func (cache *TypedCache[K, V]) SetTTL(key K, value V, ttl time.Duration) {
+	cache.mutex.Lock()
+	defer cache.mutex.Unlock()
+	item := &genericItem[V]{data: value}
+	item.touch(ttl)
+	cache.items[key] = item
+}
+
+// Get is a thread-safe way to lookup items
+// Every lookup, also touches the item, hence extending it's life
+func (cache *TypedCache[K, V]) Get(key K) (value V, found bool) {
+	cache.mutex.Lock()
+	defer cache.mutex.Unlock()
+	item, exists := cache.items[key]
+	if !exists || item.expired() {
+		var zero V
+		return zero, false
+	}
+	if cache.isTTL {
+		item.touch(cache.ttl)
+	}
+	return item.data, true
+}
+
+// Delete removes an entry from the cache at the specified key.
+// If no entry exists at the specified key, no action is taken
+func (cache *TypedCache[K, V]) Delete(key K) {
+	cache.mutex.Lock()
+	defer cache.mutex.Unlock()
+	delete(cache.items, key)
+}
+
+// Count returns the number of items in the cache
+func (cache *TypedCache[K, V]) Count() int {
+	cache.mutex.RLock()
+	defer cache.mutex.RUnlock()
+	return len(cache.items)
+}
+
+// Clear removes all entries from the cache
+func (cache *TypedCache[K, V]) Clear() {
+	cache.mutex.Lock()
+	defer cache.mutex.Unlock()
+	for key := range cache.items {
+		delete(cache.items, key)
+	}
+}
+
+func (cache *TypedCache[K, V]) ttlCleanup() {
+	cache.mutex.Lock()
+	defer cache.mutex.Unlock()
+	if cache.isTTL {
+		for key, item := range cache.items {
+			if item.expired() {
+				delete(cache.items, key)
+			}
+		}
+	}
+}
+
+func (cache *TypedCache[K, V]) startTTLCleanupTimer() {
+	if cache.isTTL {
+		duration := cache.ttl
+		if duration < time.Millisecond {
+			duration = time.Millisecond
+		}
+		ticker := time.Tick(duration)
+		go (func() {
+			for {
+				select {
+				case <-ticker:
+					cache.ttlCleanup()
+				}
+			}
+		})()
+	}
+}
@@ -0,0 +1,38 @@
+package ttlcache
+
+import (
+	"testing"
+	"time"
+)
+
+func TestTypedCacheGetSet(t *testing.T) {
+	cache := NewTypedCache[string, int](time.Second)
+
+	if _, found := cache.Get("missing"); found {
+		t.Errorf("Expected empty cache to return no data")
+	}
+
+	cache.Set("hello", 42)
+	value, found := cache.Get("hello")
+	if !found || value != 42 {
+		t.Errorf("Expected cache to return 42 for `hello`, got %v, found=%v", value, found)
+	}
+
+	cache.Delete("hello")
+	if _, found := cache.Get("hello"); found {
+		t.Errorf("Expected `hello` to be deleted")
+	}
+}
+
+func TestTypedCacheExpiration(t *testing.T) {
+	cache := NewTypedCache[string, string](200 * time.Millisecond)
+	cache.Set("x", "1")
+
+	<-time.After(400 * time.Millisecond)
+	if _, found := cache.Get("x"); found {
+		t.Errorf("Expected `x` to have expired")
+	}
+	if cache.Count() != 0 {
+		t.Errorf("Expected cache to be empty after expiration, got %d", cache.Count())
+	}
+}
@@ -0,0 +1,28 @@
+package ttlcache
+
+import "time"
+
+// Item represents a single cache entry along with its expiration time
+type Item struct {
+	data    interface{}
+	expires *time.Time
+
+	// mode overrides the cache's ExpirationMode for this item alone.
+	// nil means the cache's default mode applies.
+	mode *ExpirationMode
+}
+
+// touch resets the item's expiration time to now + duration
+func (item *Item) touch(duration time.Duration) {
+	expiration := time.Now().Add(duration)
+	item.expires = &expiration
+}
+
+// expired returns true if the item has an expiration time set and
+// that time has already passed
+func (item *Item) expired() bool {
+	if item.expires == nil {
+		return false
+	}
+	return item.expires.Before(time.Now())
+}
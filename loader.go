@@ -0,0 +1,70 @@
+package ttlcache
+
+import (
+	"errors"
+	"sync"
+)
+
+// ErrNoLoader is returned by GetOrLoad when the cache misses and no
+// Loader has been configured.
+var ErrNoLoader = errors.New("ttlcache: no loader configured")
+
+// loadCall represents a Loader invocation in flight for a given key.
+// Callers that request the same key while a load is running block on wg
+// instead of triggering their own call to Loader.
+type loadCall struct {
+	wg    sync.WaitGroup
+	value interface{}
+	err   error
+}
+
+// GetOrLoad returns the cached value for key, invoking Loader on a miss
+// (or expired entry) to populate it. Concurrent callers for the same key
+// share a single Loader invocation rather than each triggering their own.
+func (cache *Cache) GetOrLoad(key string) (interface{}, error) {
+	if data, found := cache.Get(key); found {
+		return data, nil
+	}
+	if cache.Loader == nil {
+		return nil, ErrNoLoader
+	}
+
+	cache.loadsMutex.Lock()
+	if call, ok := cache.loads[key]; ok {
+		cache.loadsMutex.Unlock()
+		call.wg.Wait()
+		return call.value, call.err
+	}
+	// A load for key may have finished between the Get above and taking
+	// loadsMutex; check the cache again before starting another one.
+	cache.mutex.RLock()
+	if elem, exists := cache.items[key]; exists && !elem.Value.(*entry).item.expired() {
+		data := elem.Value.(*entry).item.data
+		cache.mutex.RUnlock()
+		cache.loadsMutex.Unlock()
+		return data, nil
+	}
+	cache.mutex.RUnlock()
+	call := &loadCall{}
+	call.wg.Add(1)
+	cache.loads[key] = call
+	cache.loadsMutex.Unlock()
+
+	value, ttl, err := cache.Loader(key)
+	call.value, call.err = value, err
+
+	if err == nil {
+		if ttl > 0 {
+			cache.SetTTL(key, value, ttl)
+		} else {
+			cache.Set(key, value)
+		}
+	}
+
+	cache.loadsMutex.Lock()
+	delete(cache.loads, key)
+	cache.loadsMutex.Unlock()
+	call.wg.Done()
+
+	return value, err
+}
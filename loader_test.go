@@ -0,0 +1,98 @@
+package ttlcache
+
+import (
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+func TestGetOrLoadNoLoader(t *testing.T) {
+	cache := NewCache()
+	if _, err := cache.GetOrLoad("hello"); err != ErrNoLoader {
+		t.Errorf("Expected ErrNoLoader, got %v", err)
+	}
+}
+
+func TestGetOrLoadPopulatesCache(t *testing.T) {
+	cache := NewCache()
+	cache.Loader = func(key string) (interface{}, time.Duration, error) {
+		return "value-" + key, 0, nil
+	}
+
+	value, err := cache.GetOrLoad("hello")
+	if err != nil || value != "value-hello" {
+		t.Errorf("Expected `value-hello`, got %v, err=%v", value, err)
+	}
+
+	data, found := cache.Get("hello")
+	if !found || data != "value-hello" {
+		t.Errorf("Expected loaded value to be cached, got %v, found=%v", data, found)
+	}
+}
+
+func TestGetOrLoadCoalescesConcurrentCalls(t *testing.T) {
+	cache := NewCache()
+	var calls int32
+	release := make(chan struct{})
+	cache.Loader = func(key string) (interface{}, time.Duration, error) {
+		atomic.AddInt32(&calls, 1)
+		<-release
+		return "value", 0, nil
+	}
+
+	var wg sync.WaitGroup
+	results := make([]interface{}, 10)
+	for i := 0; i < 10; i++ {
+		wg.Add(1)
+		go func(i int) {
+			defer wg.Done()
+			value, err := cache.GetOrLoad("hello")
+			if err != nil {
+				t.Errorf("Unexpected error: %v", err)
+			}
+			results[i] = value
+		}(i)
+	}
+
+	// give the goroutines a chance to pile up behind the in-flight call
+	time.Sleep(50 * time.Millisecond)
+	close(release)
+	wg.Wait()
+
+	if got := atomic.LoadInt32(&calls); got != 1 {
+		t.Errorf("Expected Loader to be called exactly once, got %d", got)
+	}
+	for i, value := range results {
+		if value != "value" {
+			t.Errorf("Expected caller %d to get `value`, got %v", i, value)
+		}
+	}
+}
+
+func TestGetOrLoadPublishesBeforeReleasingWaiters(t *testing.T) {
+	cache := NewCache()
+	var calls int32
+	cache.Loader = func(key string) (interface{}, time.Duration, error) {
+		atomic.AddInt32(&calls, 1)
+		return "value", 0, nil
+	}
+
+	for i := 0; i < 1000; i++ {
+		var wg sync.WaitGroup
+		wg.Add(2)
+		go func() {
+			defer wg.Done()
+			cache.GetOrLoad("hello")
+		}()
+		go func() {
+			defer wg.Done()
+			cache.GetOrLoad("hello")
+		}()
+		wg.Wait()
+	}
+
+	if got := atomic.LoadInt32(&calls); got != 1 {
+		t.Errorf("Expected Loader to be invoked exactly once across overlapping calls, got %d", got)
+	}
+}
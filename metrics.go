@@ -0,0 +1,22 @@
+package ttlcache
+
+// Metrics is a point-in-time snapshot of a Cache's usage counters.
+type Metrics struct {
+	Hits        uint64
+	Misses      uint64
+	Insertions  uint64
+	Evictions   uint64
+	Expirations uint64
+}
+
+// Metrics returns a snapshot of the cache's usage counters, useful for
+// tracking hit ratio and eviction pressure.
+func (cache *Cache) Metrics() Metrics {
+	return Metrics{
+		Hits:        cache.hits.Load(),
+		Misses:      cache.misses.Load(),
+		Insertions:  cache.insertions.Load(),
+		Evictions:   cache.evictions.Load(),
+		Expirations: cache.expirations.Load(),
+	}
+}
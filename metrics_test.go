@@ -0,0 +1,26 @@
+package ttlcache
+
+import "testing"
+
+func TestMetrics(t *testing.T) {
+	cache := NewCache()
+
+	cache.Set("hello", "world")
+	cache.Get("hello")
+	cache.Get("missing")
+	cache.Delete("hello")
+
+	metrics := cache.Metrics()
+	if metrics.Hits != 1 {
+		t.Errorf("Expected 1 hit, got %d", metrics.Hits)
+	}
+	if metrics.Misses != 1 {
+		t.Errorf("Expected 1 miss, got %d", metrics.Misses)
+	}
+	if metrics.Insertions != 1 {
+		t.Errorf("Expected 1 insertion, got %d", metrics.Insertions)
+	}
+	if metrics.Evictions != 1 {
+		t.Errorf("Expected 1 eviction, got %d", metrics.Evictions)
+	}
+}
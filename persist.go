@@ -0,0 +1,144 @@
+package ttlcache
+
+import (
+	"encoding/gob"
+	"io"
+	"os"
+	"time"
+)
+
+// persistedItem is the on-disk representation of a single cache entry.
+// TTL holds the item's *remaining* lifetime at the time it was saved; a
+// zero TTL means the item never expires. HasMode/Mode carry a per-item
+// ExpirationMode override, if the item had one.
+type persistedItem struct {
+	Key     string
+	Value   interface{}
+	TTL     time.Duration
+	HasMode bool
+	Mode    ExpirationMode
+}
+
+// Save writes every non-expired item in the cache to w using
+// encoding/gob, so a later Load can restore them with their remaining
+// TTL intact. Because Value is stored as interface{}, callers must
+// gob.Register every concrete type they put in the cache before calling
+// Save or Load, or gob will fail to encode/decode it. Rather than abort
+// the whole snapshot over one bad value, Save skips any item that fails
+// to encode for that reason. A failure of w itself (e.g. disk full, a
+// closed pipe) is a different matter: it means the snapshot is no longer
+// trustworthy, so Save stops and returns that error instead of silently
+// continuing.
+func (cache *Cache) Save(w io.Writer) error {
+	records := cache.snapshot()
+
+	tw := &trackingWriter{w: w}
+	enc := gob.NewEncoder(tw)
+	for _, record := range records {
+		if err := enc.Encode(record); err != nil {
+			if tw.err != nil {
+				return tw.err
+			}
+			continue
+		}
+	}
+	return nil
+}
+
+// trackingWriter wraps an io.Writer and remembers the first error it
+// returns, so Save can tell a genuine write failure (stop immediately)
+// apart from a gob encoding failure that never reached the writer (skip
+// that record and keep going).
+type trackingWriter struct {
+	w   io.Writer
+	err error
+}
+
+func (t *trackingWriter) Write(p []byte) (int, error) {
+	n, err := t.w.Write(p)
+	if err != nil {
+		t.err = err
+	}
+	return n, err
+}
+
+// snapshot copies the current, non-expired items into a slice of
+// persistedItem under a brief read lock, so Save can do its (potentially
+// slow) I/O without holding the cache mutex.
+func (cache *Cache) snapshot() []persistedItem {
+	cache.mutex.RLock()
+	defer cache.mutex.RUnlock()
+
+	records := make([]persistedItem, 0, len(cache.items))
+	for key, elem := range cache.items {
+		item := elem.Value.(*entry).item
+		if item.expired() {
+			continue
+		}
+		var ttl time.Duration
+		if item.expires != nil {
+			ttl = time.Until(*item.expires)
+			if ttl <= 0 {
+				continue
+			}
+		}
+		record := persistedItem{Key: key, Value: item.data, TTL: ttl}
+		if item.mode != nil {
+			record.HasMode = true
+			record.Mode = *item.mode
+		}
+		records = append(records, record)
+	}
+	return records
+}
+
+// Load reads items previously written by Save from r and adds them to
+// the cache under their saved remaining TTL and ExpirationMode override.
+// Items saved with no expiry are added as by Set, so a TTL cache gives
+// them its default TTL. See Save for the gob.Register requirement.
+func (cache *Cache) Load(r io.Reader) error {
+	dec := gob.NewDecoder(r)
+	for {
+		var record persistedItem
+		if err := dec.Decode(&record); err != nil {
+			if err == io.EOF {
+				return nil
+			}
+			return err
+		}
+		switch {
+		case record.HasMode:
+			cache.SetTTLWithMode(record.Key, record.Value, record.TTL, record.Mode)
+		case record.TTL > 0:
+			cache.SetTTL(record.Key, record.Value, record.TTL)
+		default:
+			cache.Set(record.Key, record.Value)
+		}
+	}
+}
+
+// SaveFile is a convenience wrapper around Save that writes the snapshot
+// to the file at path, creating or truncating it as needed.
+func (cache *Cache) SaveFile(path string) error {
+	f, err := os.Create(path)
+	if err != nil {
+		return err
+	}
+	saveErr := cache.Save(f)
+	closeErr := f.Close()
+	if saveErr != nil {
+		return saveErr
+	}
+	return closeErr
+}
+
+// LoadFile is a convenience wrapper around Load that reads a snapshot
+// previously written by SaveFile.
+func (cache *Cache) LoadFile(path string) error {
+	f, err := os.Open(path)
+	if err != nil {
+		return err
+	}
+	defer f.Close()
+	return cache.Load(f)
+}
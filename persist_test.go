@@ -0,0 +1,107 @@
+package ttlcache
+
+import (
+	"bytes"
+	"errors"
+	"path/filepath"
+	"testing"
+	"time"
+)
+
+func TestSaveLoadRoundTrip(t *testing.T) {
+	cache := NewTTLCache(time.Minute)
+	cache.Set("hello", "world")
+	cache.Set("foo", "bar")
+
+	var buf bytes.Buffer
+	if err := cache.Save(&buf); err != nil {
+		t.Fatalf("Save failed: %v", err)
+	}
+
+	restored := NewTTLCache(time.Minute)
+	if err := restored.Load(&buf); err != nil {
+		t.Fatalf("Load failed: %v", err)
+	}
+
+	if data, found := restored.Get("hello"); !found || data != "world" {
+		t.Errorf("Expected `hello` to be restored as `world`, got %v, found=%v", data, found)
+	}
+	if data, found := restored.Get("foo"); !found || data != "bar" {
+		t.Errorf("Expected `foo` to be restored as `bar`, got %v, found=%v", data, found)
+	}
+}
+
+func TestSaveFileLoadFileRoundTrip(t *testing.T) {
+	cache := NewCache()
+	cache.Set("hello", "world")
+
+	path := filepath.Join(t.TempDir(), "snapshot.gob")
+	if err := cache.SaveFile(path); err != nil {
+		t.Fatalf("SaveFile failed: %v", err)
+	}
+
+	restored := NewCache()
+	if err := restored.LoadFile(path); err != nil {
+		t.Fatalf("LoadFile failed: %v", err)
+	}
+	if data, found := restored.Get("hello"); !found || data != "world" {
+		t.Errorf("Expected `hello` to be restored as `world`, got %v, found=%v", data, found)
+	}
+}
+
+func TestSaveSkipsExpiredItems(t *testing.T) {
+	cache := NewTTLCache(50 * time.Millisecond)
+	cache.Set("hello", "world")
+	<-time.After(100 * time.Millisecond)
+
+	var buf bytes.Buffer
+	if err := cache.Save(&buf); err != nil {
+		t.Fatalf("Save failed: %v", err)
+	}
+	if buf.Len() != 0 {
+		t.Errorf("Expected no bytes written for an expired item, got %d", buf.Len())
+	}
+}
+
+type failingWriter struct{ err error }
+
+func (f *failingWriter) Write(p []byte) (int, error) {
+	return 0, f.err
+}
+
+func TestSaveReturnsWriterError(t *testing.T) {
+	cache := NewCache()
+	cache.Set("hello", "world")
+
+	wantErr := errors.New("disk full")
+	err := cache.Save(&failingWriter{err: wantErr})
+	if !errors.Is(err, wantErr) {
+		t.Errorf("Expected Save to return the writer's error, got %v", err)
+	}
+}
+
+func TestSaveLoadPreservesExpirationModeOverride(t *testing.T) {
+	cache := NewTTLCache(time.Minute) // cache default is Sliding
+	cache.SetTTLWithMode("hello", "world", time.Minute, Absolute)
+
+	var buf bytes.Buffer
+	if err := cache.Save(&buf); err != nil {
+		t.Fatalf("Save failed: %v", err)
+	}
+
+	restored := NewTTLCache(time.Minute)
+	if err := restored.Load(&buf); err != nil {
+		t.Fatalf("Load failed: %v", err)
+	}
+
+	restored.mutex.RLock()
+	elem, ok := restored.items["hello"]
+	restored.mutex.RUnlock()
+	if !ok {
+		t.Fatalf("Expected `hello` to be restored")
+	}
+	mode := elem.Value.(*entry).item.mode
+	if mode == nil || *mode != Absolute {
+		t.Errorf("Expected restored `hello` to keep its Absolute override, got %v", mode)
+	}
+}
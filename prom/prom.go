@@ -0,0 +1,45 @@
+// Package prom publishes ttlcache usage metrics to Prometheus.
+package prom
+
+import (
+	"github.com/TukioHQ/ttlcache"
+	"github.com/prometheus/client_golang/prometheus"
+)
+
+// Register creates Prometheus collectors for cache's hit/miss/eviction
+// counters and current size, and registers them with reg. Every metric
+// is prefixed with name, e.g. name+"_hits_total".
+func Register(reg prometheus.Registerer, cache *ttlcache.Cache, name string) error {
+	collectors := []prometheus.Collector{
+		prometheus.NewCounterFunc(prometheus.CounterOpts{
+			Name: name + "_hits_total",
+			Help: "Number of cache lookups that found a value.",
+		}, func() float64 { return float64(cache.Metrics().Hits) }),
+		prometheus.NewCounterFunc(prometheus.CounterOpts{
+			Name: name + "_misses_total",
+			Help: "Number of cache lookups that found no value.",
+		}, func() float64 { return float64(cache.Metrics().Misses) }),
+		prometheus.NewCounterFunc(prometheus.CounterOpts{
+			Name: name + "_insertions_total",
+			Help: "Number of items written to the cache.",
+		}, func() float64 { return float64(cache.Metrics().Insertions) }),
+		prometheus.NewCounterFunc(prometheus.CounterOpts{
+			Name: name + "_evictions_total",
+			Help: "Number of items removed from the cache for any reason.",
+		}, func() float64 { return float64(cache.Metrics().Evictions) }),
+		prometheus.NewCounterFunc(prometheus.CounterOpts{
+			Name: name + "_expirations_total",
+			Help: "Number of items removed from the cache because their TTL elapsed.",
+		}, func() float64 { return float64(cache.Metrics().Expirations) }),
+		prometheus.NewGaugeFunc(prometheus.GaugeOpts{
+			Name: name + "_size",
+			Help: "Current number of items held in the cache.",
+		}, func() float64 { return float64(cache.Count()) }),
+	}
+	for _, c := range collectors {
+		if err := reg.Register(c); err != nil {
+			return err
+		}
+	}
+	return nil
+}
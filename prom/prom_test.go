@@ -0,0 +1,28 @@
+package prom
+
+import (
+	"testing"
+
+	"github.com/TukioHQ/ttlcache"
+	"github.com/prometheus/client_golang/prometheus"
+)
+
+func TestRegisterPublishesMetrics(t *testing.T) {
+	cache := ttlcache.NewCache()
+	cache.Set("hello", "world")
+	cache.Get("hello")
+	cache.Get("missing")
+
+	reg := prometheus.NewRegistry()
+	if err := Register(reg, cache, "ttlcache_test"); err != nil {
+		t.Fatalf("Register failed: %v", err)
+	}
+
+	families, err := reg.Gather()
+	if err != nil {
+		t.Fatalf("Gather failed: %v", err)
+	}
+	if len(families) != 6 {
+		t.Errorf("Expected 6 metric families, got %d", len(families))
+	}
+}